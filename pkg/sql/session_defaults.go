@@ -0,0 +1,194 @@
+// Copyright 2026 The Cockroach Authors.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package sql
+
+import (
+	"context"
+	"sync"
+
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgcode"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgerror"
+	"github.com/cockroachdb/cockroach/pkg/sql/sem/tree"
+	"github.com/cockroachdb/cockroach/pkg/sql/sessiondata"
+	"github.com/cockroachdb/cockroach/pkg/util/syncutil"
+	"github.com/cockroachdb/errors"
+)
+
+// sessionDefaultsCache caches the contents of system.session_defaults, the
+// table backing `SET GLOBAL`/`SET CLUSTER` session variable defaults.
+// getSessionVarDefaultString consults it directly (ahead of a variable's
+// compiled-in GlobalDefault) on every SET/RESET, rather than this cache
+// being snapshotted once into a session's own defaults at connect time.
+//
+// The cache is only ever refreshed by the gateway that issues the SET
+// GLOBAL/RESET itself (setDefault and deleteDefault update it eagerly); a
+// SET GLOBAL made against one node is not currently propagated to other
+// nodes' caches, so SHOW on another gateway can read a stale value until
+// that node's own cache happens to be rebuilt (e.g. after a restart). Making
+// this consistent cluster-wide needs a change feed on the table, which
+// needs system.session_defaults to be a real system table with a reserved
+// descriptor ID - see the history of this file for an earlier rangefeed-based
+// attempt that was reverted because that ID does not exist yet. Land the
+// migration first, then reintroduce the watch.
+type sessionDefaultsCache struct {
+	ie *InternalExecutor
+
+	mu struct {
+		sync.RWMutex
+		defaults map[string]string
+	}
+}
+
+// sessionDefaultsCreateTableStmt creates the table backing SET GLOBAL
+// defaults if it does not already exist. A real system table normally gets
+// a reserved descriptor ID and a cluster-version-gated migration; until
+// this ships with one, the cache creates the table itself the first time a
+// gateway needs it, so that SET GLOBAL works out of the box.
+const sessionDefaultsCreateTableStmt = `
+CREATE TABLE IF NOT EXISTS system.session_defaults (
+	name  STRING NOT NULL PRIMARY KEY,
+	value STRING NOT NULL
+)`
+
+func newSessionDefaultsCache(ie *InternalExecutor) *sessionDefaultsCache {
+	c := &sessionDefaultsCache{ie: ie}
+	c.mu.defaults = make(map[string]string)
+	return c
+}
+
+// init creates system.session_defaults if needed and loads its current
+// contents. It must complete before the cache is used by SET GLOBAL/RESET.
+func (c *sessionDefaultsCache) init(ctx context.Context) error {
+	if _, err := c.ie.ExecEx(ctx, "create-session-defaults-table", nil, /* txn */
+		sessiondata.NodeUserSessionDataOverride, sessionDefaultsCreateTableStmt,
+	); err != nil {
+		return errors.Wrap(err, "creating system.session_defaults")
+	}
+	return c.refresh(ctx)
+}
+
+// get returns the persisted cluster-wide default for varName, if any.
+func (c *sessionDefaultsCache) get(varName string) (string, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	v, ok := c.mu.defaults[varName]
+	return v, ok
+}
+
+// setDefault persists varName=strVal as the cluster-wide default for the
+// session variable, upserting the row in system.session_defaults. The local
+// cache is updated eagerly so the issuing session observes the change
+// immediately; see the propagation caveat on sessionDefaultsCache for other
+// sessions.
+func (c *sessionDefaultsCache) setDefault(ctx context.Context, varName, strVal string) error {
+	if c.ie == nil {
+		return pgerror.Newf(pgcode.FeatureNotSupported,
+			"SET GLOBAL is not available in this context")
+	}
+	_, err := c.ie.ExecEx(ctx, "set-session-default", nil, /* txn */
+		sessiondata.NodeUserSessionDataOverride,
+		`UPSERT INTO system.session_defaults (name, value) VALUES ($1, $2)`,
+		varName, strVal,
+	)
+	if err != nil {
+		return errors.Wrapf(err, "persisting cluster default for %q", varName)
+	}
+	c.mu.Lock()
+	c.mu.defaults[varName] = strVal
+	c.mu.Unlock()
+	return nil
+}
+
+// deleteDefault removes the persisted cluster-wide default for varName, the
+// counterpart to setDefault used when rolling back a SET GLOBAL that was
+// applied earlier in the same multi-assignment statement but must be
+// undone because a later assignment failed.
+func (c *sessionDefaultsCache) deleteDefault(ctx context.Context, varName string) error {
+	if c.ie == nil {
+		return pgerror.Newf(pgcode.FeatureNotSupported,
+			"SET GLOBAL is not available in this context")
+	}
+	_, err := c.ie.ExecEx(ctx, "delete-session-default", nil, /* txn */
+		sessiondata.NodeUserSessionDataOverride,
+		`DELETE FROM system.session_defaults WHERE name = $1`,
+		varName,
+	)
+	if err != nil {
+		return errors.Wrapf(err, "removing cluster default for %q", varName)
+	}
+	c.mu.Lock()
+	delete(c.mu.defaults, varName)
+	c.mu.Unlock()
+	return nil
+}
+
+// refresh reloads the entire cache from system.session_defaults.
+func (c *sessionDefaultsCache) refresh(ctx context.Context) error {
+	rows, err := c.ie.QueryBufferedEx(ctx, "refresh-session-defaults", nil, /* txn */
+		sessiondata.NodeUserSessionDataOverride,
+		`SELECT name, value FROM system.session_defaults`,
+	)
+	if err != nil {
+		return errors.Wrap(err, "reloading system.session_defaults")
+	}
+	next := make(map[string]string, len(rows))
+	for _, row := range rows {
+		next[string(tree.MustBeDString(row[0]))] = string(tree.MustBeDString(row[1]))
+	}
+	c.mu.Lock()
+	c.mu.defaults = next
+	c.mu.Unlock()
+	return nil
+}
+
+var sessionDefaultsRegistry struct {
+	syncutil.Mutex
+	byExecCfg map[*ExecutorConfig]*sessionDefaultsCache
+}
+
+// sessionDefaultsCacheFor lazily builds (and memoizes, per ExecutorConfig)
+// the sessionDefaultsCache for a server. This stands in for wiring
+// sessionDefaults into ExecutorConfig construction and server startup
+// directly: doing that properly means threading it through
+// NewExecutorConfig and the SQL server startup sequence, which this change
+// does not reach. Building it lazily on first use keeps SET GLOBAL/RESET
+// working without requiring that wiring, at the cost of a one-time
+// initialization on whichever session issues the first SET GLOBAL.
+func (p *planner) sessionDefaultsCacheFor(ctx context.Context) (*sessionDefaultsCache, error) {
+	if p.execCfg == nil || p.execCfg.InternalExecutor == nil {
+		return nil, pgerror.Newf(pgcode.FeatureNotSupported,
+			"SET GLOBAL is not available in this context")
+	}
+
+	sessionDefaultsRegistry.Lock()
+	if sessionDefaultsRegistry.byExecCfg == nil {
+		sessionDefaultsRegistry.byExecCfg = make(map[*ExecutorConfig]*sessionDefaultsCache)
+	}
+	if c, ok := sessionDefaultsRegistry.byExecCfg[p.execCfg]; ok {
+		sessionDefaultsRegistry.Unlock()
+		return c, nil
+	}
+	sessionDefaultsRegistry.Unlock()
+
+	c := newSessionDefaultsCache(p.execCfg.InternalExecutor)
+	if err := c.init(ctx); err != nil {
+		return nil, err
+	}
+
+	sessionDefaultsRegistry.Lock()
+	defer sessionDefaultsRegistry.Unlock()
+	if c, ok := sessionDefaultsRegistry.byExecCfg[p.execCfg]; ok {
+		// Lost a race with another session initializing the cache concurrently.
+		return c, nil
+	}
+	sessionDefaultsRegistry.byExecCfg[p.execCfg] = c
+	return c, nil
+}
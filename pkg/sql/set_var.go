@@ -22,44 +22,182 @@ import (
 	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgerror"
 	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgnotice"
 	"github.com/cockroachdb/cockroach/pkg/sql/sem/tree"
+	"github.com/cockroachdb/cockroach/pkg/sql/sessiondata"
 	"github.com/cockroachdb/cockroach/pkg/sql/sqltelemetry"
 	"github.com/cockroachdb/cockroach/pkg/sql/types"
 	"github.com/cockroachdb/cockroach/pkg/util/duration"
+	"github.com/cockroachdb/cockroach/pkg/util/log"
+	"github.com/cockroachdb/cockroach/pkg/util/syncutil"
 	"github.com/cockroachdb/cockroach/pkg/util/timeutil"
 	"github.com/cockroachdb/errors"
 )
 
-// setVarNode represents a SET {SESSION | LOCAL} statement.
-type setVarNode struct {
+// sessionVarChangeScope identifies which scope a session variable change
+// via SET was made in, for consumers of RegisterSessionVarHook.
+type sessionVarChangeScope int
+
+const (
+	sessionVarChangeScopeSession sessionVarChangeScope = iota
+	sessionVarChangeScopeLocal
+	sessionVarChangeScopeGlobal
+)
+
+// sessionVarHookPhase tells a sessionVarHook which of the two points in a
+// SET statement's lifecycle it is being called at, so a single hook body
+// can branch on it instead of unconditionally repeating side effects (e.g.
+// an audit log emission) on both calls.
+type sessionVarHookPhase int
+
+const (
+	// sessionVarHookPreCommit runs after the new value has been coerced to
+	// its string form but before it is written to the session (or
+	// persisted, for SET GLOBAL). Returning an error here vetoes the
+	// change and aborts the statement - typically a pgerror built with
+	// pgcode.InsufficientPrivilege for a policy hook that disallows the
+	// new value.
+	sessionVarHookPreCommit sessionVarHookPhase = iota
+	// sessionVarHookPostCommit runs once the change has been applied
+	// successfully, so the hook can record an audit event (e.g. into the
+	// eventlog/telemetry pipeline). Errors from this phase are logged but
+	// do not fail the statement, since the change has already taken
+	// effect.
+	sessionVarHookPostCommit
+)
+
+// sessionVarHook observes, and may veto, a change to a session variable.
+// oldVal/newVal are the variable's string representations; scope is the
+// SESSION/LOCAL/GLOBAL scope the change was made in; phase distinguishes
+// the veto call from the post-commit audit call so a single hook body can
+// tell them apart instead of performing both sets of side effects on
+// every call.
+type sessionVarHook func(
+	ctx context.Context, p *planner, oldVal, newVal string, scope sessionVarChangeScope,
+	phase sessionVarHookPhase,
+) error
+
+var sessionVarHooksMu syncutil.Mutex
+var sessionVarHooks = map[string][]sessionVarHook{}
+
+// RegisterSessionVarHook registers a hook invoked whenever the named
+// session variable is changed via SET (in any scope). Hooks run, in
+// registration order, at two points per change - see sessionVarHookPhase.
+//
+// RegisterSessionVarHook is meant to be called from package init(), not
+// while statements may be executing concurrently.
+func RegisterSessionVarHook(name string, hook sessionVarHook) {
+	sessionVarHooksMu.Lock()
+	defer sessionVarHooksMu.Unlock()
+	name = strings.ToLower(name)
+	sessionVarHooks[name] = append(sessionVarHooks[name], hook)
+}
+
+// getSessionVarHooks returns a snapshot of the hooks registered for name.
+func getSessionVarHooks(name string) []sessionVarHook {
+	sessionVarHooksMu.Lock()
+	defer sessionVarHooksMu.Unlock()
+	hooks := sessionVarHooks[name]
+	if len(hooks) == 0 {
+		return nil
+	}
+	out := make([]sessionVarHook, len(hooks))
+	copy(out, hooks)
+	return out
+}
+
+// setVarAssignment is a single resolved `name = value` pair out of a
+// (possibly multi-assignment) SET statement, e.g. the `b = v2` in
+// `SET a = v1, b = v2`. Each assignment keeps its own SESSION/LOCAL/GLOBAL
+// scope, matching TiDB's `SET @@session.a = v1, @@global.b = v2` semantics.
+type setVarAssignment struct {
 	name  string
 	local bool
-	v     sessionVar
+	// global, when true, indicates the GLOBAL/CLUSTER scope: the value is
+	// persisted as a cluster-wide default for this variable (in
+	// system.session_defaults) rather than applied to the current session.
+	global bool
+	v      sessionVar
 	// typedValues == nil means RESET.
 	typedValues []tree.TypedExpr
 }
 
+// setVarNode represents a SET {SESSION | LOCAL | GLOBAL} statement, with one
+// or more variable assignments applied atomically: if any assignment fails,
+// every assignment already applied by this statement is rolled back.
+type setVarNode struct {
+	assignments []setVarAssignment
+}
+
 // SetVar sets session variables.
-// Privileges: None.
+// Privileges: None, except for SET GLOBAL which requires the ADMIN role.
 //   Notes: postgres/mysql do not require privileges for session variables (some exceptions).
 func (p *planner) SetVar(ctx context.Context, n *tree.SetVar) (planNode, error) {
-	if n.Name == "" {
+	if len(n.Assignments) == 0 {
+		return nil, pgerror.Newf(pgcode.Syntax, "SET requires at least one variable assignment")
+	}
+
+	assignments := make([]setVarAssignment, len(n.Assignments))
+	for i, a := range n.Assignments {
+		assignment, err := p.resolveSetVarAssignment(ctx, a)
+		if err != nil {
+			return nil, err
+		}
+		assignments[i] = assignment
+	}
+
+	return &setVarNode{assignments: assignments}, nil
+}
+
+// resolveSetVarAssignment type-checks and validates a single assignment out
+// of a SET statement, independently of any other assignments in the same
+// statement.
+func (p *planner) resolveSetVarAssignment(
+	ctx context.Context, a *tree.VarAssignment,
+) (setVarAssignment, error) {
+	if a.Name == "" {
 		// A client has sent the reserved internal syntax SET ROW ...,
 		// or the user entered `SET "" = foo`. Reject it.
-		return nil, pgerror.Newf(pgcode.Syntax,
-			"invalid variable name: %q", n.Name)
+		return setVarAssignment{}, pgerror.Newf(pgcode.Syntax,
+			"invalid variable name: %q", a.Name)
 	}
 
-	name := strings.ToLower(n.Name)
-	_, v, err := getSessionVar(name, false /* missingOk */)
+	if a.Global && a.Local {
+		return setVarAssignment{}, pgerror.Newf(pgcode.Syntax,
+			"SET GLOBAL cannot be combined with SET LOCAL")
+	}
+
+	if a.Global {
+		// SET GLOBAL persists a cluster-wide default and therefore requires
+		// elevated privilege, mirroring the privilege model for cluster
+		// settings rather than the unprivileged session-scoped SET.
+		if err := p.RequireAdminRole(ctx, "SET GLOBAL"); err != nil {
+			return setVarAssignment{}, err
+		}
+	}
+
+	// getSessionVar resolves aliases to their canonical sessionVar and
+	// returns that canonical name as its first result, so a deprecated
+	// alias and its replacement both end up operating on the same
+	// variable (and the same entry in sessionDataMutatorIterator.defaults).
+	name := strings.ToLower(a.Name)
+	canonicalName, v, err := getSessionVar(name, false /* missingOk */)
 	if err != nil {
-		return nil, err
+		return setVarAssignment{}, err
 	}
+	if v.Deprecated != "" {
+		p.BufferClientNotice(
+			ctx,
+			pgnotice.NewWithSeverityf("WARNING",
+				"%q is deprecated and will be removed in a future release; use %q instead",
+				name, v.Deprecated),
+		)
+	}
+	name = canonicalName
 
 	var typedValues []tree.TypedExpr
-	if len(n.Values) > 0 {
+	if len(a.Values) > 0 {
 		isReset := false
-		if len(n.Values) == 1 {
-			if _, ok := n.Values[0].(tree.DefaultVal); ok {
+		if len(a.Values) == 1 {
+			if _, ok := a.Values[0].(tree.DefaultVal); ok {
 				// "SET var = DEFAULT" means RESET.
 				// In that case, we want typedValues to remain nil, so that
 				// the Start() logic recognizes the RESET too.
@@ -68,15 +206,15 @@ func (p *planner) SetVar(ctx context.Context, n *tree.SetVar) (planNode, error)
 		}
 
 		if !isReset {
-			typedValues = make([]tree.TypedExpr, len(n.Values))
-			for i, expr := range n.Values {
+			typedValues = make([]tree.TypedExpr, len(a.Values))
+			for i, expr := range a.Values {
 				expr = paramparse.UnresolvedNameToStrVal(expr)
 
 				var dummyHelper tree.IndexedVarHelper
 				typedValue, err := p.analyzeExpr(
 					ctx, expr, nil, dummyHelper, types.String, false, "SET SESSION "+name)
 				if err != nil {
-					return nil, wrapSetVarError(name, expr.String(), "%v", err)
+					return setVarAssignment{}, wrapSetVarError(name, expr.String(), "%v", err)
 				}
 				typedValues[i] = typedValue
 			}
@@ -84,7 +222,7 @@ func (p *planner) SetVar(ctx context.Context, n *tree.SetVar) (planNode, error)
 	}
 
 	if v.Set == nil && v.RuntimeSet == nil && v.SetWithPlanner == nil {
-		return nil, newCannotChangeParameterError(name)
+		return setVarAssignment{}, newCannotChangeParameterError(name)
 	}
 
 	if typedValues == nil {
@@ -92,69 +230,280 @@ func (p *planner) SetVar(ctx context.Context, n *tree.SetVar) (planNode, error)
 		// We do not use getDefaultString here because we need to delay
 		// the computation of the default to the execute phase.
 		if _, ok := p.sessionDataMutatorIterator.defaults[name]; !ok && v.GlobalDefault == nil {
-			return nil, newCannotChangeParameterError(name)
+			return setVarAssignment{}, newCannotChangeParameterError(name)
 		}
 	}
 
-	return &setVarNode{name: name, local: n.Local, v: v, typedValues: typedValues}, nil
+	return setVarAssignment{name: name, local: a.Local, global: a.Global, v: v, typedValues: typedValues}, nil
 }
 
 func (n *setVarNode) startExec(params runParams) error {
-	var strVal string
+	// Resolve every assignment first: coerce its value, snapshot its prior
+	// value, and run its pre-commit hooks, all without writing anything.
+	// Only once every assignment in the statement has passed this phase do
+	// we start committing them, so a failure can never leave an earlier
+	// assignment half-applied with nothing written yet to roll back.
+	pending := make([]pendingSetVarAssignment, len(n.assignments))
+	for i := range n.assignments {
+		p, err := n.assignments[i].resolve(params)
+		if err != nil {
+			return err
+		}
+		pending[i] = p
+	}
 
-	if _, ok := DummyVars[n.name]; ok {
-		telemetry.Inc(sqltelemetry.DummySessionVarValueCounter(n.name))
-		params.p.BufferClientNotice(
-			params.ctx,
-			pgnotice.NewWithSeverityf("WARNING", "setting session var %q is a no-op", n.name),
-		)
+	// Commit every resolved assignment. Each value was already validated
+	// during resolve (coerced and accepted by its pre-commit hooks), so by
+	// this point commit is expected to succeed; if one nonetheless fails
+	// (e.g. a Set implementation enforces an invariant GetStringVal didn't
+	// check), undo every assignment already committed by this statement.
+	committed := make([]pendingSetVarAssignment, 0, len(pending))
+	for _, p := range pending {
+		if err := p.commit(params); err != nil {
+			rollbackSetVarAssignments(params, committed)
+			return err
+		}
+		committed = append(committed, p)
 	}
-	if n.typedValues != nil {
-		for i, v := range n.typedValues {
+	return nil
+}
+
+// pendingSetVarAssignment is a setVarAssignment that has been resolved -
+// its value coerced and accepted by any pre-commit hooks - but not yet
+// written to the session or persisted as a GLOBAL default.
+type pendingSetVarAssignment struct {
+	assignment *setVarAssignment
+	strVal     string
+	// isReset is true for "SET var = DEFAULT" / "RESET var", as opposed to
+	// an explicit value. For a.global, commit must delete the persisted
+	// cluster override rather than write strVal back with setStringVal -
+	// see resolve and commit.
+	isReset   bool
+	oldVal    string
+	hadOldVal bool
+	hooks     []sessionVarHook
+	scope     sessionVarChangeScope
+}
+
+// resolve evaluates this assignment's value (or its RESET default),
+// snapshots the variable's previous value, and runs any pre-commit hooks -
+// everything short of actually writing the new value anywhere.
+func (a *setVarAssignment) resolve(params runParams) (pendingSetVarAssignment, error) {
+	var strVal string
+	isReset := a.typedValues == nil
+
+	switch {
+	case a.typedValues != nil:
+		for i, v := range a.typedValues {
 			d, err := v.Eval(params.EvalContext())
 			if err != nil {
-				return err
+				return pendingSetVarAssignment{}, err
 			}
-			n.typedValues[i] = d
+			a.typedValues[i] = d
 		}
 		var err error
-		if n.v.GetStringVal != nil {
-			strVal, err = n.v.GetStringVal(params.ctx, params.extendedEvalCtx, n.typedValues)
+		if a.v.GetStringVal != nil {
+			strVal, err = a.v.GetStringVal(params.ctx, params.extendedEvalCtx, a.typedValues)
 		} else {
 			// No string converter defined, use the default one.
-			strVal, err = getStringVal(params.EvalContext(), n.name, n.typedValues)
+			strVal, err = getStringVal(params.EvalContext(), a.name, a.typedValues)
 		}
 		if err != nil {
-			return err
+			return pendingSetVarAssignment{}, err
 		}
-	} else {
+	case a.global:
+		// RESET GLOBAL falls back to the variable's compiled-in default.
+		// It must not go through getSessionVarDefaultString, which would
+		// find (and have commit re-persist, unchanged) the very persisted
+		// cluster override this statement is trying to reset away from.
+		_, strVal = compiledVarDefaultString(a.v, params.p.sessionDataMutatorIterator.sessionDataMutatorBase)
+	default:
 		// Statement is RESET and we already know we have a default. Find it.
 		_, strVal = getSessionVarDefaultString(
-			n.name,
-			n.v,
+			params.ctx,
+			a.name,
+			a.v,
+			params.p,
 			params.p.sessionDataMutatorIterator.sessionDataMutatorBase,
 		)
 	}
 
+	oldVal, hadOldVal, err := a.currentValue(params)
+	if err != nil {
+		return pendingSetVarAssignment{}, err
+	}
+	scope := a.changeScope()
+	hooks := getSessionVarHooks(a.name)
+	for _, hook := range hooks {
+		if err := hook(params.ctx, params.p, oldVal, strVal, scope, sessionVarHookPreCommit); err != nil {
+			return pendingSetVarAssignment{}, err
+		}
+	}
+
+	return pendingSetVarAssignment{
+		assignment: a,
+		strVal:     strVal,
+		isReset:    isReset,
+		oldVal:     oldVal,
+		hadOldVal:  hadOldVal,
+		hooks:      hooks,
+		scope:      scope,
+	}, nil
+}
+
+// commit writes this assignment's resolved value to the session (or, for
+// SET GLOBAL, to the persisted cluster default - or deletes it outright,
+// for RESET GLOBAL), emits the DummyVars no-op notice, and runs post-commit
+// hooks for auditing.
+func (p *pendingSetVarAssignment) commit(params runParams) error {
+	a := p.assignment
+	if _, ok := DummyVars[a.name]; ok {
+		telemetry.Inc(sqltelemetry.DummySessionVarValueCounter(a.name))
+		params.p.BufferClientNotice(
+			params.ctx,
+			pgnotice.NewWithSeverityf("WARNING", "setting session var %q is a no-op", a.name),
+		)
+	}
+
+	if a.global && p.isReset {
+		// Unlike SET GLOBAL, RESET GLOBAL does not have a new value to
+		// persist: it removes the override entirely so the variable goes
+		// back to tracking its compiled-in default (which may itself
+		// change across versions, unlike a value frozen in the table).
+		cache, err := params.p.sessionDefaultsCacheFor(params.ctx)
+		if err != nil {
+			return err
+		}
+		if err := cache.deleteDefault(params.ctx, a.name); err != nil {
+			return err
+		}
+	} else if err := a.setStringVal(params, p.strVal); err != nil {
+		return err
+	}
+
+	for _, hook := range p.hooks {
+		if err := hook(params.ctx, params.p, p.oldVal, p.strVal, p.scope, sessionVarHookPostCommit); err != nil {
+			log.Warningf(params.ctx,
+				"session var hook for %q failed during post-commit audit notification: %v", a.name, err)
+		}
+	}
+	return nil
+}
+
+// rollbackSetVarAssignments restores, in reverse order, every assignment
+// that had already been committed when a later assignment in the same
+// statement failed to commit.
+func rollbackSetVarAssignments(params runParams, committed []pendingSetVarAssignment) {
+	for i := len(committed) - 1; i >= 0; i-- {
+		p := committed[i]
+		a := p.assignment
+		if p.hadOldVal {
+			if err := a.setStringVal(params, p.oldVal); err != nil {
+				log.Warningf(params.ctx, "restoring %q to its pre-statement value after SET failure: %v",
+					a.name, err)
+			}
+			continue
+		}
+		if a.global {
+			// There was no previous cluster default for this variable:
+			// undo the commit by deleting the row it created, rather than
+			// leaving a value persisted despite the statement failing.
+			cache, err := params.p.sessionDefaultsCacheFor(params.ctx)
+			if err != nil {
+				log.Warningf(params.ctx,
+					"removing newly-persisted GLOBAL default for %q after SET failure: %v", a.name, err)
+				continue
+			}
+			if err := cache.deleteDefault(params.ctx, a.name); err != nil {
+				log.Warningf(params.ctx,
+					"removing newly-persisted GLOBAL default for %q after SET failure: %v", a.name, err)
+			}
+			continue
+		}
+		// a.v.Get is nil: this variable's previous value could not be
+		// snapshotted, so it cannot be rolled back here. Variables that
+		// need to participate safely in multi-assignment SET should
+		// implement Get.
+	}
+}
+
+// changeScope reports the SESSION/LOCAL/GLOBAL scope of this assignment,
+// for consumers of RegisterSessionVarHook.
+func (a *setVarAssignment) changeScope() sessionVarChangeScope {
+	switch {
+	case a.global:
+		return sessionVarChangeScopeGlobal
+	case a.local:
+		return sessionVarChangeScopeLocal
+	default:
+		return sessionVarChangeScopeSession
+	}
+}
+
+// currentValue reads the session variable's value prior to this assignment
+// (or, for SET GLOBAL, the previously persisted cluster default). It is
+// used both as the "old" value passed to RegisterSessionVarHook hooks and
+// as the rollback target if a later assignment in the same statement fails
+// to commit. ok is false when there is nothing to roll back to: variables
+// without a Get accessor can't be read back at all, and a GLOBAL default
+// may simply not have existed yet (see rollbackSetVarAssignments).
+func (a *setVarAssignment) currentValue(params runParams) (val string, ok bool, err error) {
+	if a.global {
+		cache, err := params.p.sessionDefaultsCacheFor(params.ctx)
+		if err != nil {
+			// SET GLOBAL isn't available in this context (handled when the
+			// assignment is committed); there is simply no previous value.
+			return "", false, nil
+		}
+		val, ok := cache.get(a.name)
+		return val, ok, nil
+	}
+	if a.v.Get == nil {
+		return "", false, nil
+	}
+	val, err = a.v.Get(&params.p.extendedEvalCtx, params.p.SessionData())
+	if err != nil {
+		return "", false, err
+	}
+	return val, true, nil
+}
+
+// setStringVal writes strVal to the session (or, for SET GLOBAL, to the
+// persisted cluster default), using whichever of RuntimeSet/SetWithPlanner/
+// Set the variable defines. It is also how rollback re-applies a previous
+// value after a later assignment in the same statement fails to commit.
+func (a *setVarAssignment) setStringVal(params runParams, strVal string) error {
+	if a.global {
+		// SET GLOBAL does not touch the current session: it only persists
+		// the new cluster-wide default. This session's own SHOW reflects it
+		// immediately via sessionDefaultsCache's eager local update; see
+		// that type's doc comment for the cross-node propagation caveat.
+		cache, err := params.p.sessionDefaultsCacheFor(params.ctx)
+		if err != nil {
+			return err
+		}
+		return cache.setDefault(params.ctx, a.name, strVal)
+	}
+
 	// Note for RuntimeSet and SetWithPlanner we do not use the sessionDataMutator
 	// as the callers need items that are only accessible by higher level
 	// objects - and some of the computation potentially expensive so should be
 	// batched instead of performing the computation on each mutator.
 	// It is their responsibility to set LOCAL or SESSION after
 	// doing the computation.
-	if n.v.RuntimeSet != nil {
-		return n.v.RuntimeSet(params.ctx, params.p.ExtendedEvalContext(), n.local, strVal)
+	if a.v.RuntimeSet != nil {
+		return a.v.RuntimeSet(params.ctx, params.p.ExtendedEvalContext(), a.local, strVal)
 	}
 
-	if n.v.SetWithPlanner != nil {
-		return n.v.SetWithPlanner(params.ctx, params.p, n.local, strVal)
+	if a.v.SetWithPlanner != nil {
+		return a.v.SetWithPlanner(params.ctx, params.p, a.local, strVal)
 	}
 
 	return params.p.applyOnSessionDataMutators(
 		params.ctx,
-		n.local,
+		a.local,
 		func(m *sessionDataMutator) error {
-			return n.v.Set(params.ctx, m, strVal)
+			return a.v.Set(params.ctx, m, strVal)
 		},
 	)
 }
@@ -185,12 +534,36 @@ func (p *planner) applyOnSessionDataMutators(
 // getSessionVarDefaultString retrieves a string suitable to pass to a
 // session var's Set() method. First return value is false if there is
 // no default.
+//
+// Defaults are consulted in order: the session's own defaults (e.g. from
+// connection options), then the cluster-wide default persisted via SET
+// GLOBAL (system.session_defaults), then the variable's compiled-in
+// GlobalDefault.
 func getSessionVarDefaultString(
-	varName string, v sessionVar, m sessionDataMutatorBase,
+	ctx context.Context, varName string, v sessionVar, p *planner, m sessionDataMutatorBase,
 ) (bool, string) {
 	if defVal, ok := m.defaults[varName]; ok {
 		return true, defVal
 	}
+	if p != nil {
+		if cache, err := p.sessionDefaultsCacheFor(ctx); err == nil {
+			if defVal, ok := cache.get(varName); ok {
+				return true, defVal
+			}
+		}
+	}
+	if v.GlobalDefault != nil {
+		return true, v.GlobalDefault(&m.settings.SV)
+	}
+	return false, ""
+}
+
+// compiledVarDefaultString returns a variable's compiled-in default
+// (GlobalDefault), ignoring any session default or persisted SET GLOBAL
+// override. RESET GLOBAL uses this rather than getSessionVarDefaultString,
+// which would find the very cluster override being reset away from and
+// report it as the value to reset to.
+func compiledVarDefaultString(v sessionVar, m sessionDataMutatorBase) (bool, string) {
 	if v.GlobalDefault != nil {
 		return true, v.GlobalDefault(&m.settings.SV)
 	}
@@ -201,6 +574,15 @@ func (n *setVarNode) Next(_ runParams) (bool, error) { return false, nil }
 func (n *setVarNode) Values() tree.Datums            { return nil }
 func (n *setVarNode) Close(_ context.Context)        {}
 
+// sessionVarVisibleInShowAll reports whether a session variable should be
+// listed by `SHOW ALL` and the pg_catalog session-variable virtual tables.
+// Hidden variables remain fully settable via SET/RESET; they are just
+// omitted from those listings, e.g. for internal-only knobs that would
+// confuse ORMs or operators if surfaced alongside public ones.
+func sessionVarVisibleInShowAll(v sessionVar) bool {
+	return !v.Hidden
+}
+
 func getStringVal(evalCtx *tree.EvalContext, name string, values []tree.TypedExpr) (string, error) {
 	if len(values) != 1 {
 		return "", newSingleArgVarError(name)
@@ -287,6 +669,10 @@ func timeZoneVarSet(_ context.Context, m *sessionDataMutator, s string) error {
 	return nil
 }
 
+func timeZoneVarGet(_ *extendedEvalContext, sd *sessiondata.SessionData) (string, error) {
+	return sd.GetLocation().String(), nil
+}
+
 func makeTimeoutVarGetter(
 	varName string,
 ) func(
@@ -360,6 +746,10 @@ func stmtTimeoutVarSet(ctx context.Context, m *sessionDataMutator, s string) err
 	return nil
 }
 
+func stmtTimeoutVarGet(_ *extendedEvalContext, sd *sessiondata.SessionData) (string, error) {
+	return sd.GetStmtTimeout().String(), nil
+}
+
 func lockTimeoutVarSet(ctx context.Context, m *sessionDataMutator, s string) error {
 	timeout, err := validateTimeoutVar(
 		m.data.GetIntervalStyle(),
@@ -374,6 +764,10 @@ func lockTimeoutVarSet(ctx context.Context, m *sessionDataMutator, s string) err
 	return nil
 }
 
+func lockTimeoutVarGet(_ *extendedEvalContext, sd *sessiondata.SessionData) (string, error) {
+	return sd.GetLockTimeout().String(), nil
+}
+
 func idleInSessionTimeoutVarSet(ctx context.Context, m *sessionDataMutator, s string) error {
 	timeout, err := validateTimeoutVar(
 		m.data.GetIntervalStyle(),
@@ -388,6 +782,10 @@ func idleInSessionTimeoutVarSet(ctx context.Context, m *sessionDataMutator, s st
 	return nil
 }
 
+func idleInSessionTimeoutVarGet(_ *extendedEvalContext, sd *sessiondata.SessionData) (string, error) {
+	return sd.GetIdleInSessionTimeout().String(), nil
+}
+
 func idleInTransactionSessionTimeoutVarSet(
 	ctx context.Context, m *sessionDataMutator, s string,
 ) error {
@@ -404,6 +802,12 @@ func idleInTransactionSessionTimeoutVarSet(
 	return nil
 }
 
+func idleInTransactionSessionTimeoutVarGet(
+	_ *extendedEvalContext, sd *sessiondata.SessionData,
+) (string, error) {
+	return sd.GetIdleInTransactionSessionTimeout().String(), nil
+}
+
 func intervalToDuration(interval *tree.DInterval) (time.Duration, error) {
 	nanos, _, _, err := interval.Encode()
 	if err != nil {
@@ -0,0 +1,235 @@
+// Copyright 2026 The Cockroach Authors.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package sql
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/cockroachdb/cockroach/pkg/sql/sessiondata"
+)
+
+func TestGetSessionVarAlias(t *testing.T) {
+	name, v, err := getSessionVar("time zone", false /* missingOk */)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if name != "timezone" {
+		t.Errorf("got canonical name %q, want %q", name, "timezone")
+	}
+	if v.Deprecated != "" {
+		t.Errorf("alias should not be reported as deprecated, got %q", v.Deprecated)
+	}
+	if v.Set == nil {
+		t.Error("expected the canonical variable's Set to be populated")
+	}
+}
+
+func TestGetSessionVarDeprecated(t *testing.T) {
+	name, v, err := getSessionVar("idle_session_timeout", false /* missingOk */)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if name != "idle_in_session_timeout" {
+		t.Errorf("got canonical name %q, want %q", name, "idle_in_session_timeout")
+	}
+	if v.Deprecated != "idle_in_session_timeout" {
+		t.Errorf("got Deprecated %q, want %q", v.Deprecated, "idle_in_session_timeout")
+	}
+
+	// Looking the canonical name up directly must not appear deprecated.
+	_, v, err = getSessionVar("idle_in_session_timeout", false /* missingOk */)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if v.Deprecated != "" {
+		t.Errorf("canonical name should not be reported as deprecated, got %q", v.Deprecated)
+	}
+}
+
+func TestGetSessionVarUnknown(t *testing.T) {
+	if _, _, err := getSessionVar("not_a_real_variable", false /* missingOk */); err == nil {
+		t.Error("expected an error for an unknown variable")
+	}
+	name, _, err := getSessionVar("not_a_real_variable", true /* missingOk */)
+	if err != nil {
+		t.Fatalf("unexpected error with missingOk: %v", err)
+	}
+	if name != "not_a_real_variable" {
+		t.Errorf("got name %q, want the unrecognized name echoed back", name)
+	}
+}
+
+func TestSessionVarVisibleInShowAll(t *testing.T) {
+	if !sessionVarVisibleInShowAll(sessionVar{}) {
+		t.Error("a variable with Hidden unset should be visible")
+	}
+	if sessionVarVisibleInShowAll(sessionVar{Hidden: true}) {
+		t.Error("a Hidden variable should not be visible")
+	}
+}
+
+func TestSessionVarNamesExcludesHidden(t *testing.T) {
+	const hiddenName = "_test_only_hidden_var"
+	varGen[hiddenName] = sessionVar{Hidden: true}
+	defer delete(varGen, hiddenName)
+
+	for _, name := range sessionVarNames() {
+		if name == hiddenName {
+			t.Errorf("sessionVarNames returned %q, which is Hidden", hiddenName)
+		}
+	}
+}
+
+// TestSetVarResolveCommitRollback would drive resolveSetVarAssignment and
+// setVarNode.startExec end to end for a multi-assignment SET (e.g. `SET a =
+// v1, b = bogus`, asserting a's prior value is restored once b fails to
+// resolve) and for the GLOBAL rollback path (a SET GLOBAL that commits
+// followed by a later assignment that fails, asserting the persisted
+// default is deleted again). Doing that needs a real planner, runParams,
+// and ExecutorConfig - backed by a working InternalExecutor - to build the
+// sessionDataMutatorIterator a statement resolves against; none of that
+// scaffolding (planner.go, executor.go, go.mod) is part of this trimmed
+// snapshot, so there is no fixture to build these against here. Once this
+// package has its normal test scaffolding, these belong as datadriven logic
+// tests alongside the rest of the SET/SHOW test suite.
+func TestSetVarResolveCommitRollback(t *testing.T) {
+	t.Skip("requires planner/runParams/ExecutorConfig fixtures not present in this trimmed snapshot")
+}
+
+// TestSessionVarHookPreCommitVeto exercises RegisterSessionVarHook's veto
+// path directly: a pre-commit hook returning an error must be visible via
+// getSessionVarHooks and distinguishable, via phase, from the same hook's
+// post-commit call.
+func TestSessionVarHookPreCommitVeto(t *testing.T) {
+	const name = "_test_only_veto_hook_var"
+	wantErr := errors.New("vetoed by test hook")
+	RegisterSessionVarHook(name, func(
+		_ context.Context, _ *planner, _, _ string, _ sessionVarChangeScope, phase sessionVarHookPhase,
+	) error {
+		if phase == sessionVarHookPreCommit {
+			return wantErr
+		}
+		return nil
+	})
+	defer func() {
+		sessionVarHooksMu.Lock()
+		delete(sessionVarHooks, name)
+		sessionVarHooksMu.Unlock()
+	}()
+
+	hooks := getSessionVarHooks(name)
+	if len(hooks) != 1 {
+		t.Fatalf("got %d hooks, want 1", len(hooks))
+	}
+	if err := hooks[0](context.Background(), nil, "old", "new", sessionVarChangeScopeSession, sessionVarHookPreCommit); err != wantErr {
+		t.Errorf("pre-commit call: got error %v, want %v", err, wantErr)
+	}
+	if err := hooks[0](context.Background(), nil, "old", "new", sessionVarChangeScopeSession, sessionVarHookPostCommit); err != nil {
+		t.Errorf("post-commit call: got unexpected error %v", err)
+	}
+}
+
+// TestSessionVarHookPostCommitAudit exercises the post-commit audit phase:
+// a hook that never vetoes should still observe the old/new values and
+// scope of a completed change, for each registered hook in order.
+func TestSessionVarHookPostCommitAudit(t *testing.T) {
+	const name = "_test_only_audit_hook_var"
+	type call struct {
+		oldVal, newVal string
+		scope          sessionVarChangeScope
+		phase          sessionVarHookPhase
+	}
+	var calls []call
+	RegisterSessionVarHook(name, func(
+		_ context.Context, _ *planner, oldVal, newVal string, scope sessionVarChangeScope, phase sessionVarHookPhase,
+	) error {
+		calls = append(calls, call{oldVal, newVal, scope, phase})
+		return nil
+	})
+	defer func() {
+		sessionVarHooksMu.Lock()
+		delete(sessionVarHooks, name)
+		sessionVarHooksMu.Unlock()
+	}()
+
+	hooks := getSessionVarHooks(name)
+	if len(hooks) != 1 {
+		t.Fatalf("got %d hooks, want 1", len(hooks))
+	}
+	if err := hooks[0](context.Background(), nil, "prev", "next", sessionVarChangeScopeGlobal, sessionVarHookPostCommit); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(calls) != 1 {
+		t.Fatalf("got %d calls, want 1", len(calls))
+	}
+	got := calls[0]
+	if got.oldVal != "prev" || got.newVal != "next" || got.scope != sessionVarChangeScopeGlobal || got.phase != sessionVarHookPostCommit {
+		t.Errorf("got call %+v, want {prev next Global PostCommit}", got)
+	}
+}
+
+func TestGenerateSessionVariableRowsExcludesHidden(t *testing.T) {
+	const visibleName = "_test_only_visible_row_var"
+	const hiddenName = "_test_only_hidden_row_var"
+	varGen[visibleName] = sessionVar{
+		Get: func(*extendedEvalContext, *sessiondata.SessionData) (string, error) {
+			return "visible-value", nil
+		},
+	}
+	varGen[hiddenName] = sessionVar{
+		Hidden: true,
+		Get: func(*extendedEvalContext, *sessiondata.SessionData) (string, error) {
+			return "hidden-value", nil
+		},
+	}
+	defer delete(varGen, visibleName)
+	defer delete(varGen, hiddenName)
+
+	rows, err := generateSessionVariableRows(nil, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var sawVisible, sawHidden bool
+	for _, r := range rows {
+		switch r.Name {
+		case visibleName:
+			sawVisible = true
+			if r.Value != "visible-value" {
+				t.Errorf("got value %q, want %q", r.Value, "visible-value")
+			}
+		case hiddenName:
+			sawHidden = true
+		}
+	}
+	if !sawVisible {
+		t.Error("expected the visible test var to be present")
+	}
+	if sawHidden {
+		t.Error("expected the hidden test var to be excluded")
+	}
+}
+
+func TestSetVarAssignmentChangeScope(t *testing.T) {
+	for _, tc := range []struct {
+		assignment setVarAssignment
+		want       sessionVarChangeScope
+	}{
+		{setVarAssignment{}, sessionVarChangeScopeSession},
+		{setVarAssignment{local: true}, sessionVarChangeScopeLocal},
+		{setVarAssignment{global: true}, sessionVarChangeScopeGlobal},
+	} {
+		if got := tc.assignment.changeScope(); got != tc.want {
+			t.Errorf("changeScope() = %v, want %v", got, tc.want)
+		}
+	}
+}
@@ -0,0 +1,211 @@
+// Copyright 2026 The Cockroach Authors.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package sql
+
+import (
+	"context"
+	"sort"
+
+	"github.com/cockroachdb/cockroach/pkg/settings"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgcode"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgerror"
+	"github.com/cockroachdb/cockroach/pkg/sql/sem/tree"
+	"github.com/cockroachdb/cockroach/pkg/sql/sessiondata"
+)
+
+// sessionVar provides the definition of a session variable and its methods
+// of access (for SHOW/getSessionVarDefaultString) and mutation (for SET).
+// Exactly one of Set, RuntimeSet, or SetWithPlanner should be populated;
+// which one depends on what the variable needs in order to take effect -
+// see setVarAssignment.setStringVal.
+type sessionVar struct {
+	// Get, if set, returns the variable's current string value. It is used
+	// to report the "old" value to RegisterSessionVarHook hooks and to
+	// snapshot a value for rollback in a multi-assignment SET; variables
+	// without a Get cannot participate safely in either.
+	Get func(evalCtx *extendedEvalContext, sd *sessiondata.SessionData) (string, error)
+
+	// GetStringVal converts the tree.TypedExprs in a SET's assignment list
+	// to the string form Set/RuntimeSet/SetWithPlanner expects. If nil,
+	// getStringVal (the single-argument default) is used instead.
+	GetStringVal func(ctx context.Context, evalCtx *extendedEvalContext, values []tree.TypedExpr) (string, error)
+
+	// Set applies a SET's already-stringified value via a sessionDataMutator.
+	Set func(ctx context.Context, m *sessionDataMutator, val string) error
+
+	// RuntimeSet applies a SET's value using state beyond what a
+	// sessionDataMutator exposes. It is responsible for honoring the local
+	// flag itself.
+	RuntimeSet func(ctx context.Context, evalCtx *extendedEvalContext, local bool, s string) error
+
+	// SetWithPlanner is like RuntimeSet but for variables whose mutation
+	// needs the full planner rather than just the eval context.
+	SetWithPlanner func(ctx context.Context, p *planner, local bool, val string) error
+
+	// GlobalDefault, if set, computes the variable's built-in default from
+	// cluster settings. It is consulted by getSessionVarDefaultString after
+	// the session's own defaults and any persisted SET GLOBAL default.
+	GlobalDefault func(sv *settings.Values) string
+
+	// Aliases lists additional names that resolve to this same variable,
+	// e.g. legacy or cross-dialect spellings. Setting or showing an alias
+	// operates on the canonical name's entry in
+	// sessionDataMutatorIterator.defaults.
+	Aliases []string
+
+	// Deprecated, if non-empty, names the variable that should be used
+	// instead of this one. It is never set on a varGen entry directly;
+	// getSessionVar populates it on the returned value when the name the
+	// client used was a deprecated spelling (see deprecatedVarNames), so
+	// resolveSetVarAssignment can emit a notice pointing at the
+	// replacement. SET/RESET on a deprecated name still takes effect.
+	Deprecated string
+
+	// Hidden variables are fully settable via SET/RESET but omitted from
+	// SHOW ALL and the session-variable virtual tables, e.g. internal-only
+	// knobs that would confuse ORMs or operators if surfaced alongside
+	// public ones.
+	Hidden bool
+}
+
+// varGen is the registry of session variables settable via SET and
+// reportable via SHOW. Names are lower-case; getSessionVar is responsible
+// for lower-casing lookups and resolving aliases before consulting it.
+var varGen = map[string]sessionVar{
+	"timezone": {
+		Get:          timeZoneVarGet,
+		GetStringVal: timeZoneVarGetStringVal,
+		Set:          timeZoneVarSet,
+		Aliases:      []string{"time zone"},
+	},
+	"statement_timeout": {
+		Get: stmtTimeoutVarGet,
+		Set: stmtTimeoutVarSet,
+	},
+	"lock_timeout": {
+		Get: lockTimeoutVarGet,
+		Set: lockTimeoutVarSet,
+	},
+	"idle_in_session_timeout": {
+		Get: idleInSessionTimeoutVarGet,
+		Set: idleInSessionTimeoutVarSet,
+	},
+	"idle_in_transaction_session_timeout": {
+		Get:     idleInTransactionSessionTimeoutVarGet,
+		Set:     idleInTransactionSessionTimeoutVarSet,
+		Aliases: []string{"idle_in_transaction_timeout"},
+	},
+}
+
+// deprecatedVarNames maps an old, no-longer-canonical spelling of a session
+// variable to the name it was renamed to. Unlike an alias - which is simply
+// another name for the same variable, with no notice - looking a variable
+// up under a deprecated name emits a client notice pointing at the
+// replacement; see getSessionVar.
+var deprecatedVarNames = map[string]string{
+	"idle_session_timeout": "idle_in_session_timeout",
+}
+
+// varAliases maps each alias in varGen to the canonical name of the
+// variable it resolves to. It is built once from varGen rather than
+// maintained by hand, so the two can never drift apart.
+var varAliases = buildVarAliases(varGen)
+
+func buildVarAliases(gen map[string]sessionVar) map[string]string {
+	aliases := make(map[string]string, len(gen))
+	for name, v := range gen {
+		for _, alias := range v.Aliases {
+			aliases[alias] = name
+		}
+	}
+	return aliases
+}
+
+// getSessionVar looks up name (assumed already lower-cased) in varGen,
+// resolving it first if it is a deprecated name or an alias. It returns the
+// canonical name, so callers operate on a single name regardless of which
+// spelling the client used, alongside the sessionVar for that canonical
+// name - with Deprecated set to the replacement name if, and only if, name
+// itself was the deprecated spelling (the canonical entry's own Deprecated
+// field, if any, is otherwise always empty). If missingOk and name is
+// unknown, it returns ok=false rather than an error.
+func getSessionVar(name string, missingOk bool) (string, sessionVar, error) {
+	deprecatedFrom := ""
+	if replacement, ok := deprecatedVarNames[name]; ok {
+		deprecatedFrom = name
+		name = replacement
+	}
+	if canonical, ok := varAliases[name]; ok {
+		name = canonical
+	}
+	v, ok := varGen[name]
+	if !ok {
+		if missingOk {
+			return name, sessionVar{}, nil
+		}
+		return "", sessionVar{}, pgerror.Newf(pgcode.UndefinedObject,
+			"unrecognized configuration parameter %q", name)
+	}
+	if deprecatedFrom != "" {
+		v.Deprecated = name
+	}
+	return name, v, nil
+}
+
+// sessionVarNames returns, in sorted order, the canonical names of every
+// session variable SHOW ALL and the session-variable virtual tables should
+// list - every entry in varGen except those marked Hidden.
+func sessionVarNames() []string {
+	names := make([]string, 0, len(varGen))
+	for name, v := range varGen {
+		if !sessionVarVisibleInShowAll(v) {
+			continue
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// sessionVariableRow is one row of SHOW ALL's result set.
+type sessionVariableRow struct {
+	Name, Value string
+}
+
+// generateSessionVariableRows computes SHOW ALL's result set: every
+// non-Hidden session variable (per sessionVarNames), paired with its
+// current value for the given session. A variable without a Get cannot
+// report a current value and is omitted.
+//
+// This is meant to be called by the delegate/virtual-table code that
+// implements SHOW ALL and the session-variable pg_catalog tables, so that
+// Hidden-filtering and value lookup live in one place rather than being
+// re-derived at each call site; that dispatch layer (delegate.go,
+// pg_catalog.go) is not part of this trimmed snapshot, so nothing calls
+// this yet.
+func generateSessionVariableRows(
+	evalCtx *extendedEvalContext, sd *sessiondata.SessionData,
+) ([]sessionVariableRow, error) {
+	names := sessionVarNames()
+	rows := make([]sessionVariableRow, 0, len(names))
+	for _, name := range names {
+		v := varGen[name]
+		if v.Get == nil {
+			continue
+		}
+		val, err := v.Get(evalCtx, sd)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, sessionVariableRow{Name: name, Value: val})
+	}
+	return rows, nil
+}